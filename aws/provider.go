@@ -0,0 +1,47 @@
+package aws
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Provider returns a terraform.ResourceProvider for AWS.
+func Provider() terraform.ResourceProvider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"access_key": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The access key for API operations.",
+			},
+			"secret_key": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The secret key for API operations.",
+			},
+			"profile": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The profile for API operations.",
+			},
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The region where AWS operations will take place.",
+			},
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_iam_role_policy_attachment":  resourceAwsIamRolePolicyAttachment(),
+			"aws_iam_policy_attachment":       resourceAwsIamPolicyAttachment(),
+			"aws_iam_user_policy_attachment":  resourceAwsIamUserPolicyAttachment(),
+			"aws_iam_group_policy_attachment": resourceAwsIamGroupPolicyAttachment(),
+			"aws_iam_role":                    resourceAwsIamRole(),
+			"aws_iam_role_policy":             resourceAwsIamRolePolicy(),
+			"aws_iam_policy":                  resourceAwsIamPolicy(),
+			"aws_iam_instance_profile":        resourceAwsIamInstanceProfile(),
+		},
+
+		ConfigureFunc: providerConfigure,
+	}
+}
@@ -0,0 +1,119 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsIamGroupPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIamGroupPolicyAttachmentCreate,
+		Read:   resourceAwsIamGroupPolicyAttachmentRead,
+		Delete: resourceAwsIamGroupPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				parts := strings.SplitN(d.Id(), ":", 2)
+				if len(parts) != 2 {
+					return []*schema.ResourceData{}, fmt.Errorf("[ERR] Wrong format of resource: %s. Please follow 'group-name:policy-arn'", d.Id())
+				}
+				group := parts[0]
+				policyArn := parts[1]
+				d.Set("group", group)
+				d.Set("policy_arn", policyArn)
+				d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", group)))
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"group": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policy_arn": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsIamGroupPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	group := d.Get("group").(string)
+	arn := d.Get("policy_arn").(string)
+
+	err := attachPolicyToGroup(conn, group, arn)
+	if err != nil {
+		return fmt.Errorf("[WARN] Error attaching policy %s to IAM Group %s: %v", arn, group, err)
+	}
+
+	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", group)))
+	return resourceAwsIamGroupPolicyAttachmentRead(d, meta)
+}
+
+func resourceAwsIamGroupPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+	group := d.Get("group").(string)
+	arn := d.Get("policy_arn").(string)
+
+	_, err := conn.GetGroup(&iam.GetGroupInput{
+		GroupName: aws.String(group),
+	})
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == "NoSuchEntity" {
+				log.Printf("[WARN] No such entity found for Policy Attachment (%s)", group)
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	args := iam.ListAttachedGroupPoliciesInput{
+		GroupName: aws.String(group),
+	}
+	var policy string
+	err = conn.ListAttachedGroupPoliciesPages(&args, func(page *iam.ListAttachedGroupPoliciesOutput, lastPage bool) bool {
+		for _, p := range page.AttachedPolicies {
+			if *p.PolicyArn == arn {
+				policy = *p.PolicyArn
+			}
+		}
+
+		return policy == ""
+	})
+	if err != nil {
+		return err
+	}
+	if policy == "" {
+		log.Printf("[WARN] No such policy found for Group Policy Attachment (%s)", group)
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceAwsIamGroupPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+	group := d.Get("group").(string)
+	arn := d.Get("policy_arn").(string)
+
+	err := detachPolicyFromGroup(conn, group, arn)
+	if err != nil {
+		return fmt.Errorf("[WARN] Error removing policy %s from IAM Group %s: %v", arn, group, err)
+	}
+	return nil
+}
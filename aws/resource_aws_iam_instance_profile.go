@@ -0,0 +1,162 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsIamInstanceProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIamInstanceProfileCreate,
+		Read:   resourceAwsIamInstanceProfileRead,
+		Update: resourceAwsIamInstanceProfileUpdate,
+		Delete: resourceAwsIamInstanceProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("name", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"path": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "/",
+			},
+			"role": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"arn": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"unique_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsIamInstanceProfileCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+	name := d.Get("name").(string)
+
+	resp, err := conn.CreateInstanceProfile(&iam.CreateInstanceProfileInput{
+		InstanceProfileName: aws.String(name),
+		Path:                aws.String(d.Get("path").(string)),
+	})
+	if err != nil {
+		return fmt.Errorf("[WARN] Error creating IAM Instance Profile %s: %v", name, err)
+	}
+
+	d.SetId(*resp.InstanceProfile.InstanceProfileName)
+
+	if role, ok := d.GetOk("role"); ok {
+		if _, err := conn.AddRoleToInstanceProfile(&iam.AddRoleToInstanceProfileInput{
+			InstanceProfileName: aws.String(name),
+			RoleName:            aws.String(role.(string)),
+		}); err != nil {
+			return fmt.Errorf("[WARN] Error adding IAM Role %s to Instance Profile %s: %v", role, name, err)
+		}
+	}
+
+	return resourceAwsIamInstanceProfileRead(d, meta)
+}
+
+func resourceAwsIamInstanceProfileRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+	name := d.Id()
+
+	resp, err := conn.GetInstanceProfile(&iam.GetInstanceProfileInput{
+		InstanceProfileName: aws.String(name),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == "NoSuchEntity" {
+				log.Printf("[WARN] No such entity found for IAM Instance Profile (%s)", name)
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	profile := resp.InstanceProfile
+	d.Set("name", profile.InstanceProfileName)
+	d.Set("path", profile.Path)
+	d.Set("arn", profile.Arn)
+	d.Set("unique_id", profile.InstanceProfileId)
+
+	if len(profile.Roles) > 0 {
+		d.Set("role", *profile.Roles[0].RoleName)
+	} else {
+		d.Set("role", "")
+	}
+
+	return nil
+}
+
+func resourceAwsIamInstanceProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+	name := d.Id()
+
+	if d.HasChange("role") {
+		o, n := d.GetChange("role")
+
+		if old := o.(string); old != "" {
+			if _, err := conn.RemoveRoleFromInstanceProfile(&iam.RemoveRoleFromInstanceProfileInput{
+				InstanceProfileName: aws.String(name),
+				RoleName:            aws.String(old),
+			}); err != nil {
+				return fmt.Errorf("[WARN] Error removing IAM Role %s from Instance Profile %s: %v", old, name, err)
+			}
+		}
+
+		if new := n.(string); new != "" {
+			if _, err := conn.AddRoleToInstanceProfile(&iam.AddRoleToInstanceProfileInput{
+				InstanceProfileName: aws.String(name),
+				RoleName:            aws.String(new),
+			}); err != nil {
+				return fmt.Errorf("[WARN] Error adding IAM Role %s to Instance Profile %s: %v", new, name, err)
+			}
+		}
+	}
+
+	return resourceAwsIamInstanceProfileRead(d, meta)
+}
+
+func resourceAwsIamInstanceProfileDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+	name := d.Id()
+
+	if role, ok := d.GetOk("role"); ok {
+		if _, err := conn.RemoveRoleFromInstanceProfile(&iam.RemoveRoleFromInstanceProfileInput{
+			InstanceProfileName: aws.String(name),
+			RoleName:            aws.String(role.(string)),
+		}); err != nil {
+			return fmt.Errorf("[WARN] Error removing IAM Role %s from Instance Profile %s: %v", role, name, err)
+		}
+	}
+
+	_, err := conn.DeleteInstanceProfile(&iam.DeleteInstanceProfileInput{
+		InstanceProfileName: aws.String(name),
+	})
+	if err != nil {
+		return fmt.Errorf("[WARN] Error deleting IAM Instance Profile %s: %v", name, err)
+	}
+	return nil
+}
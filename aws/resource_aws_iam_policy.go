@@ -0,0 +1,174 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsIamPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIamPolicyCreate,
+		Read:   resourceAwsIamPolicyRead,
+		Update: resourceAwsIamPolicyUpdate,
+		Delete: resourceAwsIamPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				conn := meta.(*AWSClient).iamconn
+				arn := d.Id()
+
+				resp, err := conn.GetPolicy(&iam.GetPolicyInput{
+					PolicyArn: aws.String(arn),
+				})
+				if err != nil {
+					return []*schema.ResourceData{}, err
+				}
+				policy := resp.Policy
+
+				versionResp, err := conn.GetPolicyVersion(&iam.GetPolicyVersionInput{
+					PolicyArn: aws.String(arn),
+					VersionId: policy.DefaultVersionId,
+				})
+				if err != nil {
+					return []*schema.ResourceData{}, err
+				}
+
+				document, err := url.QueryUnescape(*versionResp.PolicyVersion.Document)
+				if err != nil {
+					return []*schema.ResourceData{}, err
+				}
+
+				d.Set("name", policy.PolicyName)
+				d.Set("path", policy.Path)
+				d.Set("description", policy.Description)
+				d.Set("policy", document)
+				d.SetId(arn)
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"path": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "/",
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"policy": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"arn": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsIamPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+	name := d.Get("name").(string)
+
+	request := &iam.CreatePolicyInput{
+		Description:    aws.String(d.Get("description").(string)),
+		Path:           aws.String(d.Get("path").(string)),
+		PolicyDocument: aws.String(d.Get("policy").(string)),
+		PolicyName:     aws.String(name),
+	}
+
+	resp, err := conn.CreatePolicy(request)
+	if err != nil {
+		return fmt.Errorf("[WARN] Error creating IAM Policy %s: %v", name, err)
+	}
+
+	d.SetId(*resp.Policy.Arn)
+	return resourceAwsIamPolicyRead(d, meta)
+}
+
+func resourceAwsIamPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+	arn := d.Id()
+
+	resp, err := conn.GetPolicy(&iam.GetPolicyInput{
+		PolicyArn: aws.String(arn),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == "NoSuchEntity" {
+				log.Printf("[WARN] No such entity found for IAM Policy (%s)", arn)
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+	policy := resp.Policy
+
+	versionResp, err := conn.GetPolicyVersion(&iam.GetPolicyVersionInput{
+		PolicyArn: aws.String(arn),
+		VersionId: policy.DefaultVersionId,
+	})
+	if err != nil {
+		return err
+	}
+
+	document, err := url.QueryUnescape(*versionResp.PolicyVersion.Document)
+	if err != nil {
+		return err
+	}
+
+	d.Set("name", policy.PolicyName)
+	d.Set("path", policy.Path)
+	d.Set("description", policy.Description)
+	d.Set("arn", policy.Arn)
+	d.Set("policy", document)
+
+	return nil
+}
+
+func resourceAwsIamPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+	arn := d.Id()
+
+	if d.HasChange("policy") {
+		if _, err := conn.CreatePolicyVersion(&iam.CreatePolicyVersionInput{
+			PolicyArn:      aws.String(arn),
+			PolicyDocument: aws.String(d.Get("policy").(string)),
+			SetAsDefault:   aws.Bool(true),
+		}); err != nil {
+			return fmt.Errorf("[WARN] Error updating IAM Policy %s: %v", arn, err)
+		}
+	}
+
+	return resourceAwsIamPolicyRead(d, meta)
+}
+
+func resourceAwsIamPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+	arn := d.Id()
+
+	_, err := conn.DeletePolicy(&iam.DeletePolicyInput{
+		PolicyArn: aws.String(arn),
+	})
+	if err != nil {
+		return fmt.Errorf("[WARN] Error deleting IAM Policy %s: %v", arn, err)
+	}
+	return nil
+}
@@ -0,0 +1,258 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsIamPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIamPolicyAttachmentCreate,
+		Read:   resourceAwsIamPolicyAttachmentRead,
+		Update: resourceAwsIamPolicyAttachmentUpdate,
+		Delete: resourceAwsIamPolicyAttachmentDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"users": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"roles": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"groups": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"policy_arn": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsIamPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+	name := d.Get("name").(string)
+	arn := d.Get("policy_arn").(string)
+
+	users := expandStringList(d.Get("users").(*schema.Set).List())
+	roles := expandStringList(d.Get("roles").(*schema.Set).List())
+	groups := expandStringList(d.Get("groups").(*schema.Set).List())
+
+	if len(users) == 0 && len(roles) == 0 && len(groups) == 0 {
+		return fmt.Errorf("[WARN] No Users, Roles, or Groups specified for IAM Policy Attachment %s", name)
+	}
+
+	if err := fanOutIamPolicyCalls(flattenStringPtrList(users), func(u string) error {
+		return attachPolicyToUser(conn, u, arn)
+	}); err != nil {
+		return fmt.Errorf("[WARN] Error attaching policy %s to IAM Users: %v", arn, err)
+	}
+	if err := fanOutIamPolicyCalls(flattenStringPtrList(roles), func(r string) error {
+		return attachPolicyToRole(conn, r, []string{arn})
+	}); err != nil {
+		return fmt.Errorf("[WARN] Error attaching policy %s to IAM Roles: %v", arn, err)
+	}
+	if err := fanOutIamPolicyCalls(flattenStringPtrList(groups), func(g string) error {
+		return attachPolicyToGroup(conn, g, arn)
+	}); err != nil {
+		return fmt.Errorf("[WARN] Error attaching policy %s to IAM Groups: %v", arn, err)
+	}
+
+	d.SetId(name)
+	return resourceAwsIamPolicyAttachmentRead(d, meta)
+}
+
+func resourceAwsIamPolicyAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+	arn := d.Get("policy_arn").(string)
+
+	if d.HasChange("users") {
+		o, n := d.GetChange("users")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+		remove := flattenStringPtrList(expandStringList(os.Difference(ns).List()))
+		add := flattenStringPtrList(expandStringList(ns.Difference(os).List()))
+
+		if err := fanOutIamPolicyCalls(remove, func(u string) error {
+			return detachPolicyFromUser(conn, u, arn)
+		}); err != nil {
+			return fmt.Errorf("[WARN] Error detaching policy %s from IAM Users: %v", arn, err)
+		}
+		if err := fanOutIamPolicyCalls(add, func(u string) error {
+			return attachPolicyToUser(conn, u, arn)
+		}); err != nil {
+			return fmt.Errorf("[WARN] Error attaching policy %s to IAM Users: %v", arn, err)
+		}
+	}
+
+	if d.HasChange("roles") {
+		o, n := d.GetChange("roles")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+		remove := flattenStringPtrList(expandStringList(os.Difference(ns).List()))
+		add := flattenStringPtrList(expandStringList(ns.Difference(os).List()))
+
+		if err := fanOutIamPolicyCalls(remove, func(r string) error {
+			return detachPolicyFromRole(conn, r, []string{arn})
+		}); err != nil {
+			return fmt.Errorf("[WARN] Error detaching policy %s from IAM Roles: %v", arn, err)
+		}
+		if err := fanOutIamPolicyCalls(add, func(r string) error {
+			return attachPolicyToRole(conn, r, []string{arn})
+		}); err != nil {
+			return fmt.Errorf("[WARN] Error attaching policy %s to IAM Roles: %v", arn, err)
+		}
+	}
+
+	if d.HasChange("groups") {
+		o, n := d.GetChange("groups")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+		remove := flattenStringPtrList(expandStringList(os.Difference(ns).List()))
+		add := flattenStringPtrList(expandStringList(ns.Difference(os).List()))
+
+		if err := fanOutIamPolicyCalls(remove, func(g string) error {
+			return detachPolicyFromGroup(conn, g, arn)
+		}); err != nil {
+			return fmt.Errorf("[WARN] Error detaching policy %s from IAM Groups: %v", arn, err)
+		}
+		if err := fanOutIamPolicyCalls(add, func(g string) error {
+			return attachPolicyToGroup(conn, g, arn)
+		}); err != nil {
+			return fmt.Errorf("[WARN] Error attaching policy %s to IAM Groups: %v", arn, err)
+		}
+	}
+
+	return resourceAwsIamPolicyAttachmentRead(d, meta)
+}
+
+func resourceAwsIamPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+	arn := d.Get("policy_arn").(string)
+
+	var users []string
+	var roles []string
+	var groups []string
+
+	args := iam.ListEntitiesForPolicyInput{
+		PolicyArn: aws.String(arn),
+	}
+	err := conn.ListEntitiesForPolicyPages(&args, func(page *iam.ListEntitiesForPolicyOutput, lastPage bool) bool {
+		for _, u := range page.PolicyUsers {
+			users = append(users, *u.UserName)
+		}
+		for _, r := range page.PolicyRoles {
+			roles = append(roles, *r.RoleName)
+		}
+		for _, g := range page.PolicyGroups {
+			groups = append(groups, *g.GroupName)
+		}
+		return true
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == "NoSuchEntity" {
+				log.Printf("[WARN] No such policy found for IAM Policy Attachment (%s)", arn)
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.Set("users", users)
+	d.Set("roles", roles)
+	d.Set("groups", groups)
+
+	return nil
+}
+
+func resourceAwsIamPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+	arn := d.Get("policy_arn").(string)
+
+	users := expandStringList(d.Get("users").(*schema.Set).List())
+	roles := expandStringList(d.Get("roles").(*schema.Set).List())
+	groups := expandStringList(d.Get("groups").(*schema.Set).List())
+
+	if err := fanOutIamPolicyCalls(flattenStringPtrList(users), func(u string) error {
+		return detachPolicyFromUser(conn, u, arn)
+	}); err != nil {
+		return fmt.Errorf("[WARN] Error detaching policy %s from IAM Users: %v", arn, err)
+	}
+	if err := fanOutIamPolicyCalls(flattenStringPtrList(roles), func(r string) error {
+		return detachPolicyFromRole(conn, r, []string{arn})
+	}); err != nil {
+		return fmt.Errorf("[WARN] Error detaching policy %s from IAM Roles: %v", arn, err)
+	}
+	if err := fanOutIamPolicyCalls(flattenStringPtrList(groups), func(g string) error {
+		return detachPolicyFromGroup(conn, g, arn)
+	}); err != nil {
+		return fmt.Errorf("[WARN] Error detaching policy %s from IAM Groups: %v", arn, err)
+	}
+
+	return nil
+}
+
+func attachPolicyToUser(conn *iam.IAM, user string, arn string) error {
+	_, err := conn.AttachUserPolicy(&iam.AttachUserPolicyInput{
+		UserName:  aws.String(user),
+		PolicyArn: aws.String(arn),
+	})
+	return err
+}
+
+func detachPolicyFromUser(conn *iam.IAM, user string, arn string) error {
+	_, err := conn.DetachUserPolicy(&iam.DetachUserPolicyInput{
+		UserName:  aws.String(user),
+		PolicyArn: aws.String(arn),
+	})
+	return err
+}
+
+func attachPolicyToGroup(conn *iam.IAM, group string, arn string) error {
+	_, err := conn.AttachGroupPolicy(&iam.AttachGroupPolicyInput{
+		GroupName: aws.String(group),
+		PolicyArn: aws.String(arn),
+	})
+	return err
+}
+
+func detachPolicyFromGroup(conn *iam.IAM, group string, arn string) error {
+	_, err := conn.DetachGroupPolicy(&iam.DetachGroupPolicyInput{
+		GroupName: aws.String(group),
+		PolicyArn: aws.String(arn),
+	})
+	return err
+}
+
+// flattenStringPtrList dereferences every *string in the slice so it can be
+// passed to fanOutIamPolicyCalls, which fans out over plain strings.
+func flattenStringPtrList(in []*string) []string {
+	out := make([]string, len(in))
+	for i, v := range in {
+		out[i] = *v
+	}
+	return out
+}
@@ -0,0 +1,142 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsIamRole() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIamRoleCreate,
+		Read:   resourceAwsIamRoleRead,
+		Update: resourceAwsIamRoleUpdate,
+		Delete: resourceAwsIamRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("name", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"path": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "/",
+			},
+			"assume_role_policy": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"arn": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_date": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"unique_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsIamRoleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+	name := d.Get("name").(string)
+
+	request := &iam.CreateRoleInput{
+		Path:                     aws.String(d.Get("path").(string)),
+		RoleName:                 aws.String(name),
+		AssumeRolePolicyDocument: aws.String(d.Get("assume_role_policy").(string)),
+	}
+
+	resp, err := conn.CreateRole(request)
+	if err != nil {
+		return fmt.Errorf("[WARN] Error creating IAM Role %s: %v", name, err)
+	}
+
+	d.SetId(*resp.Role.RoleName)
+	return resourceAwsIamRoleRead(d, meta)
+}
+
+func resourceAwsIamRoleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+	name := d.Id()
+
+	resp, err := conn.GetRole(&iam.GetRoleInput{
+		RoleName: aws.String(name),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == "NoSuchEntity" {
+				log.Printf("[WARN] No such entity found for IAM Role (%s)", name)
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	role := resp.Role
+	d.Set("name", role.RoleName)
+	d.Set("path", role.Path)
+	d.Set("arn", role.Arn)
+	d.Set("unique_id", role.RoleId)
+	if role.CreateDate != nil {
+		d.Set("create_date", role.CreateDate.Format("2006-01-02T15:04:05Z"))
+	}
+
+	if role.AssumeRolePolicyDocument != nil {
+		policy, err := url.QueryUnescape(*role.AssumeRolePolicyDocument)
+		if err != nil {
+			return err
+		}
+		d.Set("assume_role_policy", policy)
+	}
+
+	return nil
+}
+
+func resourceAwsIamRoleUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	if d.HasChange("assume_role_policy") {
+		_, err := conn.UpdateAssumeRolePolicy(&iam.UpdateAssumeRolePolicyInput{
+			RoleName:       aws.String(d.Id()),
+			PolicyDocument: aws.String(d.Get("assume_role_policy").(string)),
+		})
+		if err != nil {
+			return fmt.Errorf("[WARN] Error updating assume role policy for IAM Role %s: %v", d.Id(), err)
+		}
+	}
+
+	return resourceAwsIamRoleRead(d, meta)
+}
+
+func resourceAwsIamRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	_, err := conn.DeleteRole(&iam.DeleteRoleInput{
+		RoleName: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("[WARN] Error deleting IAM Role %s: %v", d.Id(), err)
+	}
+	return nil
+}
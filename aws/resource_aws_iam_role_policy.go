@@ -0,0 +1,131 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsIamRolePolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIamRolePolicyPut,
+		Read:   resourceAwsIamRolePolicyRead,
+		Update: resourceAwsIamRolePolicyPut,
+		Delete: resourceAwsIamRolePolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				parts := strings.SplitN(d.Id(), ":", 2)
+				if len(parts) != 2 {
+					return []*schema.ResourceData{}, fmt.Errorf("[ERR] Wrong format of resource: %s. Please follow 'role:policy-name'", d.Id())
+				}
+				role := parts[0]
+				name := parts[1]
+
+				conn := meta.(*AWSClient).iamconn
+				if _, err := conn.GetRolePolicy(&iam.GetRolePolicyInput{
+					RoleName:   aws.String(role),
+					PolicyName: aws.String(name),
+				}); err != nil {
+					return []*schema.ResourceData{}, err
+				}
+
+				d.Set("role", role)
+				d.Set("name", name)
+				d.SetId(fmt.Sprintf("%s:%s", role, name))
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"role": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policy": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceAwsIamRolePolicyPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	role := d.Get("role").(string)
+	name := d.Get("name").(string)
+
+	request := &iam.PutRolePolicyInput{
+		RoleName:       aws.String(role),
+		PolicyName:     aws.String(name),
+		PolicyDocument: aws.String(d.Get("policy").(string)),
+	}
+
+	if _, err := conn.PutRolePolicy(request); err != nil {
+		return fmt.Errorf("[WARN] Error putting IAM Role Policy %s: %v", name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", role, name))
+	return resourceAwsIamRolePolicyRead(d, meta)
+}
+
+func resourceAwsIamRolePolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	role := d.Get("role").(string)
+	name := d.Get("name").(string)
+
+	resp, err := conn.GetRolePolicy(&iam.GetRolePolicyInput{
+		RoleName:   aws.String(role),
+		PolicyName: aws.String(name),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == "NoSuchEntity" {
+				log.Printf("[WARN] No such entity found for IAM Role Policy (%s)", d.Id())
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	policy, err := url.QueryUnescape(*resp.PolicyDocument)
+	if err != nil {
+		return err
+	}
+	d.Set("policy", policy)
+	d.Set("name", resp.PolicyName)
+	d.Set("role", resp.RoleName)
+
+	return nil
+}
+
+func resourceAwsIamRolePolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	role := d.Get("role").(string)
+	name := d.Get("name").(string)
+
+	_, err := conn.DeleteRolePolicy(&iam.DeleteRolePolicyInput{
+		RoleName:   aws.String(role),
+		PolicyName: aws.String(name),
+	})
+	if err != nil {
+		return fmt.Errorf("[WARN] Error deleting IAM Role Policy %s: %v", name, err)
+	}
+	return nil
+}
@@ -4,14 +4,20 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"sync"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+// iamPolicyAttachmentConcurrency bounds how many Attach/Detach RolePolicy
+// calls are in flight at once when fanning out across many policy ARNs.
+const iamPolicyAttachmentConcurrency = 10
+
 func resourceAwsIamRolePolicyAttachment() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsIamRolePolicyAttachmentCreate,
@@ -25,6 +31,28 @@ func resourceAwsIamRolePolicyAttachment() *schema.Resource {
 				}
 				role := parts[0]
 				policyArn := parts[1]
+
+				conn := meta.(*AWSClient).iamconn
+				attached := false
+				args := iam.ListAttachedRolePoliciesInput{
+					RoleName: aws.String(role),
+				}
+				err := conn.ListAttachedRolePoliciesPages(&args, func(page *iam.ListAttachedRolePoliciesOutput, lastPage bool) bool {
+					for _, p := range page.AttachedPolicies {
+						if *p.PolicyArn == policyArn {
+							attached = true
+							return false
+						}
+					}
+					return true
+				})
+				if err != nil {
+					return []*schema.ResourceData{}, err
+				}
+				if !attached {
+					return []*schema.ResourceData{}, fmt.Errorf("[ERR] Policy %s is not attached to Role %s", policyArn, role)
+				}
+
 				d.Set("role", role)
 				d.Set("policy_arn", policyArn)
 				d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", role)))
@@ -53,7 +81,7 @@ func resourceAwsIamRolePolicyAttachmentCreate(d *schema.ResourceData, meta inter
 	role := d.Get("role").(string)
 	arn := d.Get("policy_arn").(string)
 
-	err := attachPolicyToRole(conn, role, arn)
+	err := attachPolicyToRole(conn, role, []string{arn})
 	if err != nil {
 		return fmt.Errorf("[WARN] Error attaching policy %s to IAM Role %s: %v", arn, role, err)
 	}
@@ -111,31 +139,63 @@ func resourceAwsIamRolePolicyAttachmentDelete(d *schema.ResourceData, meta inter
 	role := d.Get("role").(string)
 	arn := d.Get("policy_arn").(string)
 
-	err := detachPolicyFromRole(conn, role, arn)
+	err := detachPolicyFromRole(conn, role, []string{arn})
 	if err != nil {
 		return fmt.Errorf("[WARN] Error removing policy %s from IAM Role %s: %v", arn, role, err)
 	}
 	return nil
 }
 
-func attachPolicyToRole(conn *iam.IAM, role string, arn string) error {
-	_, err := conn.AttachRolePolicy(&iam.AttachRolePolicyInput{
-		RoleName:  aws.String(role),
-		PolicyArn: aws.String(arn),
-	})
-	if err != nil {
+// attachPolicyToRole attaches every policy ARN in arns to role, fanning the
+// AttachRolePolicy calls out across a bounded worker pool so that roles with
+// many managed policies don't pay for them serially.
+func attachPolicyToRole(conn *iam.IAM, role string, arns []string) error {
+	return fanOutIamPolicyCalls(arns, func(arn string) error {
+		_, err := conn.AttachRolePolicy(&iam.AttachRolePolicyInput{
+			RoleName:  aws.String(role),
+			PolicyArn: aws.String(arn),
+		})
 		return err
-	}
-	return nil
+	})
 }
 
-func detachPolicyFromRole(conn *iam.IAM, role string, arn string) error {
-	_, err := conn.DetachRolePolicy(&iam.DetachRolePolicyInput{
-		RoleName:  aws.String(role),
-		PolicyArn: aws.String(arn),
-	})
-	if err != nil {
+// detachPolicyFromRole is the DetachRolePolicy counterpart to attachPolicyToRole.
+func detachPolicyFromRole(conn *iam.IAM, role string, arns []string) error {
+	return fanOutIamPolicyCalls(arns, func(arn string) error {
+		_, err := conn.DetachRolePolicy(&iam.DetachRolePolicyInput{
+			RoleName:  aws.String(role),
+			PolicyArn: aws.String(arn),
+		})
 		return err
+	})
+}
+
+// fanOutIamPolicyCalls runs fn once per ARN in arns, bounded to
+// iamPolicyAttachmentConcurrency concurrent calls, and aggregates any errors
+// into a single multierror.
+func fanOutIamPolicyCalls(arns []string, fn func(arn string) error) error {
+	var (
+		wg   sync.WaitGroup
+		sem  = make(chan struct{}, iamPolicyAttachmentConcurrency)
+		mu   sync.Mutex
+		errs *multierror.Error
+	)
+
+	for _, arn := range arns {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(arn string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := fn(arn); err != nil {
+				mu.Lock()
+				errs = multierror.Append(errs, err)
+				mu.Unlock()
+			}
+		}(arn)
 	}
-	return nil
+	wg.Wait()
+
+	return errs.ErrorOrNil()
 }
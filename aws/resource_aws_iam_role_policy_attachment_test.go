@@ -0,0 +1,142 @@
+package aws
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSIAMRolePolicyAttachment_importBasic(t *testing.T) {
+	resourceName := "aws_iam_role_policy_attachment.test-attach"
+	rName := fmt.Sprintf("tf-acc-test-%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMRolePolicyAttachConfig(rName),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("not found: %s", resourceName)
+					}
+					return fmt.Sprintf("%s:%s", rs.Primary.Attributes["role"], rs.Primary.Attributes["policy_arn"]), nil
+				},
+			},
+		},
+	})
+}
+
+func testAccAWSIAMRolePolicyAttachConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "role" {
+  name = "%s"
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {"Service": "ec2.amazonaws.com"},
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_policy" "policy" {
+  name = "%s"
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "iam:ListRoles",
+      "Effect": "Allow",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy_attachment" "test-attach" {
+  role       = "${aws_iam_role.role.name}"
+  policy_arn = "${aws_iam_policy.policy.arn}"
+}
+`, rName, rName)
+}
+
+func TestFanOutIamPolicyCalls(t *testing.T) {
+	const total = 25
+
+	arns := make([]string, total)
+	failing := make(map[string]bool, total)
+	for i := range arns {
+		arns[i] = fmt.Sprintf("arn:aws:iam::123456789012:policy/test-%d", i)
+		if i%5 == 0 {
+			failing[arns[i]] = true
+		}
+	}
+
+	var (
+		mu       sync.Mutex
+		called   = make(map[string]bool, total)
+		inFlight int32
+		peak     int32
+	)
+
+	err := fanOutIamPolicyCalls(arns, func(arn string) error {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+
+		mu.Lock()
+		called[arn] = true
+		mu.Unlock()
+
+		if failing[arn] {
+			return fmt.Errorf("failed for %s", arn)
+		}
+		return nil
+	})
+
+	for _, arn := range arns {
+		if !called[arn] {
+			t.Errorf("expected fn to be called for %s", arn)
+		}
+	}
+
+	if peak > iamPolicyAttachmentConcurrency {
+		t.Errorf("concurrency exceeded bound: got %d, want <= %d", peak, iamPolicyAttachmentConcurrency)
+	}
+
+	merr, ok := err.(*multierror.Error)
+	if !ok {
+		t.Fatalf("expected *multierror.Error, got %T", err)
+	}
+	if len(merr.Errors) != len(failing) {
+		t.Fatalf("expected %d aggregated errors, got %d", len(failing), len(merr.Errors))
+	}
+}
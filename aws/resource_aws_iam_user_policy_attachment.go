@@ -0,0 +1,119 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsIamUserPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIamUserPolicyAttachmentCreate,
+		Read:   resourceAwsIamUserPolicyAttachmentRead,
+		Delete: resourceAwsIamUserPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				parts := strings.SplitN(d.Id(), ":", 2)
+				if len(parts) != 2 {
+					return []*schema.ResourceData{}, fmt.Errorf("[ERR] Wrong format of resource: %s. Please follow 'user-name:policy-arn'", d.Id())
+				}
+				user := parts[0]
+				policyArn := parts[1]
+				d.Set("user", user)
+				d.Set("policy_arn", policyArn)
+				d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", user)))
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"user": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policy_arn": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsIamUserPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	user := d.Get("user").(string)
+	arn := d.Get("policy_arn").(string)
+
+	err := attachPolicyToUser(conn, user, arn)
+	if err != nil {
+		return fmt.Errorf("[WARN] Error attaching policy %s to IAM User %s: %v", arn, user, err)
+	}
+
+	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", user)))
+	return resourceAwsIamUserPolicyAttachmentRead(d, meta)
+}
+
+func resourceAwsIamUserPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+	user := d.Get("user").(string)
+	arn := d.Get("policy_arn").(string)
+
+	_, err := conn.GetUser(&iam.GetUserInput{
+		UserName: aws.String(user),
+	})
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == "NoSuchEntity" {
+				log.Printf("[WARN] No such entity found for Policy Attachment (%s)", user)
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	args := iam.ListAttachedUserPoliciesInput{
+		UserName: aws.String(user),
+	}
+	var policy string
+	err = conn.ListAttachedUserPoliciesPages(&args, func(page *iam.ListAttachedUserPoliciesOutput, lastPage bool) bool {
+		for _, p := range page.AttachedPolicies {
+			if *p.PolicyArn == arn {
+				policy = *p.PolicyArn
+			}
+		}
+
+		return policy == ""
+	})
+	if err != nil {
+		return err
+	}
+	if policy == "" {
+		log.Printf("[WARN] No such policy found for User Policy Attachment (%s)", user)
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceAwsIamUserPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+	user := d.Get("user").(string)
+	arn := d.Get("policy_arn").(string)
+
+	err := detachPolicyFromUser(conn, user, arn)
+	if err != nil {
+		return fmt.Errorf("[WARN] Error removing policy %s from IAM User %s: %v", arn, user, err)
+	}
+	return nil
+}